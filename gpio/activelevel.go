@@ -0,0 +1,39 @@
+package gpio
+
+// An ActiveLevelSetter can have its active level configured, allowing a
+// driver to present an active-low pin to application code as if it were
+// active-high.
+//
+// The default active level, before SetActiveLevel is called, is High.
+// When the active level is Low, a logical High passed to a
+// ValueSetter or returned from a ValueGetter corresponds to the pin
+// physically being driven or read as Low, and vice-versa. This lets
+// application code reason about signals in logical terms (e.g. "active"
+// and "inactive") while the driver takes care of the board-level
+// inversion once, at pin-acquisition time.
+type ActiveLevelSetter interface {
+	SetActiveLevel(level Value) (err error)
+}
+
+// ActiveLevelConfig is an enumeration type for specifying a desired
+// active level in a configuration struct such as LineConfig, where a
+// plain Value would be ambiguous: Value's zero value is a real,
+// meaningful level (Low), so a zero-initialized struct field couldn't
+// be told apart from an explicit choice of Low. ActiveLevelConfigUnset
+// plays the same role here that PullNoChange plays for Pull.
+type ActiveLevelConfig int
+
+const (
+	// ActiveLevelConfigUnset leaves the active level at whatever
+	// default the driver applies on its own, which per
+	// ActiveLevelSetter is High unless configured otherwise.
+	ActiveLevelConfigUnset ActiveLevelConfig = 0
+
+	// ActiveLevelConfigHigh explicitly configures the line as
+	// active-high.
+	ActiveLevelConfigHigh ActiveLevelConfig = 1
+
+	// ActiveLevelConfigLow explicitly configures the line as
+	// active-low.
+	ActiveLevelConfigLow ActiveLevelConfig = 2
+)
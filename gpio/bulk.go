@@ -0,0 +1,64 @@
+package gpio
+
+// LineConfig describes how a single line within a bulk line request
+// should be configured. It composes the configuration surfaces of the
+// per-pin interfaces (DirectionSetter, Biaser, DriveModeSetter,
+// ActiveLevelSetter) into a single value, since a bulk request
+// configures all of its lines atomically up front rather than through
+// later calls to per-pin setters.
+type LineConfig struct {
+	// Direction is the initial data direction for the line.
+	Direction Direction
+
+	// Pull is the initial bias for the line. PullNoChange leaves the
+	// hardware default in place.
+	Pull Pull
+
+	// Drive is the initial output drive mode for the line. It is
+	// ignored for lines configured with Direction In.
+	Drive DriveMode
+
+	// ActiveLevel is the logical active level for the line, as per
+	// ActiveLevelSetter. The zero value, ActiveLevelConfigUnset,
+	// leaves the driver's own default (High) in place.
+	ActiveLevel ActiveLevelConfig
+
+	// Consumer is a human-readable label identifying the owner of the
+	// line, as per Labeler.
+	Consumer string
+}
+
+// A BulkPin represents a group of GPIO lines requested together, and
+// supports reading and writing all of them atomically in a single
+// operation. This mirrors the line-request model of the Linux gpiochip
+// character device, and is useful for parallel buses (such as an 8-bit
+// LCD data bus) or anywhere else that tearing between individually-set
+// lines would be observable.
+//
+// The order of values and directions in the slices passed to and
+// returned from a BulkPin's methods corresponds to the order of line
+// numbers passed to the BulkOpener that created it.
+type BulkPin interface {
+	// SetValues atomically sets the value of every line in the group.
+	// len(values) must equal the number of lines in the group.
+	SetValues(values []Value) (err error)
+
+	// Values atomically reads the value of every line in the group.
+	Values() (values []Value, err error)
+
+	// SetDirections atomically sets the direction of every line in the
+	// group. len(dirs) must equal the number of lines in the group.
+	SetDirections(dirs []Direction) (err error)
+}
+
+// A BulkOpener can request a group of GPIO lines together, to be
+// operated on atomically via the returned BulkPin.
+//
+// lines gives the line numbers to request, in the order in which their
+// values and directions will appear in the BulkPin's slices. configs
+// must either have the same length as lines, giving per-line
+// configuration, or have length one, in which case the single
+// LineConfig is applied to every line.
+type BulkOpener interface {
+	OpenBulk(lines []int, configs []LineConfig) (pin BulkPin, err error)
+}
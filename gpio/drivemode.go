@@ -0,0 +1,49 @@
+package gpio
+
+import "errors"
+
+// ErrUnsupportedDriveMode is returned by DriveModeSetter.SetDriveMode
+// implementations when asked to configure a drive mode that the
+// underlying hardware or driver does not support.
+var ErrUnsupportedDriveMode = errors.New("unsupported drive mode")
+
+// DriveMode is an enumeration type describing how an output pin drives
+// its signal.
+type DriveMode int
+
+const (
+	// PushPull actively drives the pin to both Low and High, which is
+	// the default drive mode for most GPIO hardware.
+	PushPull DriveMode = 0
+
+	// OpenDrain only actively drives the pin Low, leaving it floating
+	// (requiring an external or configured pull-up to read High) when
+	// set High. This is required for wired-OR buses and for signaling
+	// on lines shared with other drivers, such as I2C SDA/SCL.
+	OpenDrain DriveMode = 1
+
+	// OpenSource only actively drives the pin High, leaving it floating
+	// when set Low. This is the mirror image of OpenDrain and is much
+	// less commonly available in hardware.
+	OpenSource DriveMode = 2
+)
+
+func (mode DriveMode) String() string {
+	switch mode {
+	case PushPull:
+		return "PushPull"
+	case OpenDrain:
+		return "OpenDrain"
+	case OpenSource:
+		return "OpenSource"
+	default:
+		panic("Cannot String Invalid DriveMode")
+	}
+}
+
+// A DriveModeSetter can have its output drive mode configured. Drivers
+// that cannot support a requested drive mode should return
+// ErrUnsupportedDriveMode rather than silently ignoring the request.
+type DriveModeSetter interface {
+	SetDriveMode(mode DriveMode) (err error)
+}
@@ -0,0 +1,53 @@
+package gpio
+
+import (
+	"context"
+	"time"
+)
+
+// An Event describes a single edge observed on a pin that is being
+// monitored via EdgeNotifier.Notify.
+type Event struct {
+	// Time is the moment at which the edge was observed. Precision is
+	// limited by the underlying implementation and by the scheduling
+	// latency of the Go runtime, so callers needing precise timing
+	// should not rely on this being exact.
+	Time time.Time
+
+	// Value is the pin value immediately after the edge occurred.
+	Value Value
+
+	// Edge is the specific edge that was observed, which will always
+	// be either RisingEdge or FallingEdge, even if the notifier as a
+	// whole was configured with BothEdges sensitivity.
+	Edge EdgeSensitivity
+}
+
+// An EdgeNotifier is a more flexible alternative to EdgeWaiter that allows
+// a caller to wait for an edge alongside other work (via a context.Context)
+// or to receive a continuous stream of edge events on a channel.
+//
+// EdgeNotifier embeds EdgeWaiter, so every implementation also provides
+// SetSensitivity and WaitForEdge; SetSensitivity selects the edges that
+// WaitForEdgeContext and Notify respond to.
+type EdgeNotifier interface {
+	EdgeWaiter
+
+	// WaitForEdgeContext blocks until an edge matching the sensitivity
+	// previously set by SetSensitivity occurs, the given context is
+	// cancelled, or an error occurs. If the context is cancelled before
+	// an edge occurs, it returns the context's error.
+	WaitForEdgeContext(ctx context.Context) (value Value, err error)
+
+	// Notify arranges for an Event to be sent on ch each time an edge
+	// matching the current sensitivity occurs, until Stop is called
+	// with the same channel. Callers must ensure that ch is not closed
+	// until after calling Stop, and should ensure that ch has enough
+	// buffer (or a fast enough reader) to avoid blocking the notifier.
+	Notify(ch chan<- Event) (err error)
+
+	// Stop unregisters a channel previously passed to Notify, after
+	// which no further Events will be sent to it. Stop is a no-op if
+	// ch was never registered, or was already stopped.
+	Stop(ch chan<- Event)
+}
@@ -62,23 +62,32 @@ type DirectionSetter interface {
 }
 
 // A PullStopper can disable a pull-up or pull-down resistor.
+//
+// Prefer Biaser in new code: it can distinguish "disable bias" from
+// "leave as-is" and supports reading back the current configuration.
 type PullStopper interface {
 	StopPulling() (err error)
 }
 
 // An UpPuller can enable a pull-up resistor (which, if it is also a
 // DownPuller, may implicitly stop pulling down.)
+//
+// Prefer Biaser in new code; see PullStopper.
 type UpPuller interface {
 	PullUp() (err error)
 }
 
 // A DownPuller can enable a pull-down resistor (which, if it is also an
 // UpPuller, may implicitly stop pulling up.)
+//
+// Prefer Biaser in new code; see PullStopper.
 type DownPuller interface {
 	PullDown() (err error)
 }
 
 // A Puller can enable and disable pull-up and pull-down resistors.
+//
+// Prefer Biaser in new code; see PullStopper.
 type Puller interface {
 	UpPuller
 	DownPuller
@@ -95,6 +104,10 @@ type Puller interface {
 // should depend directly on ValueGetter or ValueSetter to illustrate clearly
 // to the user how the GPIO pin will be used by the driver, and thus help avoid
 // unusual situations like two devices trying to drive the same signal.
+//
+// Implementations returned from driver constructors are expected to
+// also satisfy Closer, so that callers can release the underlying
+// kernel or hardware resources once they are done with the pin.
 type GpioPin interface {
 	ValueGetter
 	ValueSetter
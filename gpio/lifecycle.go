@@ -0,0 +1,35 @@
+package gpio
+
+// A Closer releases whatever kernel or hardware resources a pin holds.
+// Once Close returns, the pin must not be used again.
+type Closer interface {
+	Close() (err error)
+}
+
+// A Labeler can attach a human-readable consumer label to a pin,
+// identifying the process or subsystem that holds it. This corresponds
+// to the consumer label attached to a gpiochip line request, as shown
+// by tools such as gpioinfo.
+type Labeler interface {
+	// SetConsumer sets the consumer label. Drivers may restrict when
+	// this can be called, for example only before the pin's direction
+	// is first set.
+	SetConsumer(label string) (err error)
+
+	// Consumer returns the currently-set consumer label, or "" if none
+	// has been set.
+	Consumer() string
+}
+
+// A Namer identifies a pin by the name and/or number it is known by on
+// the underlying hardware, for use by diagnostic tooling that
+// enumerates held lines.
+type Namer interface {
+	// Name returns the hardware's name for the pin, such as a
+	// gpiochip line name, or "" if the hardware doesn't have one.
+	Name() string
+
+	// Number returns the hardware's numeric identifier for the pin,
+	// such as a gpiochip line offset.
+	Number() int
+}
@@ -0,0 +1,87 @@
+package gpio
+
+import "fmt"
+
+// Pull is an enumeration type describing the bias (pull-up or pull-down
+// resistor) configuration of a GPIO pin.
+type Pull uint8
+
+const (
+	// PullNoChange leaves the pin's current bias configuration untouched.
+	// It is only meaningful as an argument to SetPull; Pull never
+	// returns it as a current state.
+	PullNoChange Pull = 0
+
+	// PullFloat disables any pull-up or pull-down resistor, leaving the
+	// pin electrically floating when not actively driven.
+	PullFloat Pull = 1
+
+	// PullDown enables a pull-down resistor.
+	PullDown Pull = 2
+
+	// PullUp enables a pull-up resistor.
+	PullUp Pull = 3
+)
+
+func (pull Pull) String() string {
+	switch pull {
+	case PullNoChange:
+		return "PullNoChange"
+	case PullFloat:
+		return "PullFloat"
+	case PullDown:
+		return "PullDown"
+	case PullUp:
+		return "PullUp"
+	default:
+		panic("Cannot String Invalid Pull")
+	}
+}
+
+// A Biaser can have its pull-up/pull-down bias configured and read back.
+// This is the preferred replacement for the older Puller/UpPuller/
+// DownPuller/PullStopper interfaces, since it can represent PullFloat
+// (no bias at all) as distinct from PullNoChange (leave as-is), and
+// allows reading back the currently-configured bias.
+type Biaser interface {
+	// SetPull configures the pin's bias. Passing PullNoChange is a no-op.
+	SetPull(pull Pull) (err error)
+
+	// Pull returns the pin's currently-configured bias. It never
+	// returns PullNoChange.
+	Pull() (pull Pull, err error)
+}
+
+// SetPullVia adapts an old-style Puller (or any subset of UpPuller,
+// DownPuller and PullStopper) to the Biaser API, for drivers that have
+// not yet migrated to implementing Biaser directly.
+//
+// puller must implement at least one of UpPuller, DownPuller or
+// PullStopper appropriate to the requested pull; if it doesn't,
+// SetPullVia returns an error. PullNoChange is always a no-op.
+func SetPullVia(puller any, pull Pull) (err error) {
+	switch pull {
+	case PullNoChange:
+		return nil
+	case PullUp:
+		up, ok := puller.(UpPuller)
+		if !ok {
+			return fmt.Errorf("%T does not support pull-up", puller)
+		}
+		return up.PullUp()
+	case PullDown:
+		down, ok := puller.(DownPuller)
+		if !ok {
+			return fmt.Errorf("%T does not support pull-down", puller)
+		}
+		return down.PullDown()
+	case PullFloat:
+		stopper, ok := puller.(PullStopper)
+		if !ok {
+			return fmt.Errorf("%T does not support disabling its pull resistor", puller)
+		}
+		return stopper.StopPulling()
+	default:
+		return fmt.Errorf("invalid Pull value %d", pull)
+	}
+}
@@ -0,0 +1,73 @@
+package gpio
+
+import "testing"
+
+type fakePuller struct {
+	upCalled   bool
+	downCalled bool
+	stopCalled bool
+	returnErr  error
+}
+
+func (p *fakePuller) PullUp() error {
+	p.upCalled = true
+	return p.returnErr
+}
+
+func (p *fakePuller) PullDown() error {
+	p.downCalled = true
+	return p.returnErr
+}
+
+func (p *fakePuller) StopPulling() error {
+	p.stopCalled = true
+	return p.returnErr
+}
+
+type upOnlyPuller struct{}
+
+func (upOnlyPuller) PullUp() error { return nil }
+
+func TestSetPullViaNoChange(t *testing.T) {
+	p := &fakePuller{}
+	if err := SetPullVia(p, PullNoChange); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if p.upCalled || p.downCalled || p.stopCalled {
+		t.Fatalf("PullNoChange should not call any puller method, got %+v", p)
+	}
+}
+
+func TestSetPullViaDelegates(t *testing.T) {
+	tests := []struct {
+		pull    Pull
+		wantUp  bool
+		wantDwn bool
+		wantStp bool
+	}{
+		{PullUp, true, false, false},
+		{PullDown, false, true, false},
+		{PullFloat, false, false, true},
+	}
+	for _, test := range tests {
+		p := &fakePuller{}
+		if err := SetPullVia(p, test.pull); err != nil {
+			t.Fatalf("SetPullVia(%s): unexpected error: %s", test.pull, err)
+		}
+		if p.upCalled != test.wantUp || p.downCalled != test.wantDwn || p.stopCalled != test.wantStp {
+			t.Fatalf("SetPullVia(%s): got %+v", test.pull, p)
+		}
+	}
+}
+
+func TestSetPullViaUnsupported(t *testing.T) {
+	if err := SetPullVia(upOnlyPuller{}, PullDown); err == nil {
+		t.Fatal("expected an error when requesting PullDown on an UpPuller-only type")
+	}
+}
+
+func TestSetPullViaInvalidPull(t *testing.T) {
+	if err := SetPullVia(&fakePuller{}, Pull(99)); err == nil {
+		t.Fatal("expected an error for an invalid Pull value")
+	}
+}
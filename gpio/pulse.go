@@ -0,0 +1,89 @@
+package gpio
+
+import (
+	"context"
+	"time"
+)
+
+// A PulseTimer can measure the duration for which a pin remains in a
+// given state. This is useful for protocols that encode data as pulse
+// widths, such as HC-SR04 ultrasonic rangefinders or DHT11/DHT22
+// humidity sensors.
+//
+// Because it relies on user-space scheduling, the precision of a
+// PulseTimer is inherently limited; drivers that can offer a more
+// precise, e.g. kernel- or hardware-timestamped measurement should
+// prefer doing so, but TimePulseVia provides a portable fallback for
+// those that can't.
+type PulseTimer interface {
+	// TimePulse waits for the pin to reach the given state, then
+	// measures how long it remains in that state before leaving it,
+	// returning the measured duration.
+	TimePulse(state Value) (duration time.Duration, err error)
+}
+
+// TimePulseVia implements PulseTimer.TimePulse on top of any
+// EdgeWaiter, for drivers that have no faster native way to measure a
+// pulse width. It overwrites the waiter's sensitivity as a side effect.
+func TimePulseVia(waiter EdgeWaiter, state Value) (duration time.Duration, err error) {
+	return timePulseVia(context.Background(), waiter, state)
+}
+
+// TimePulseContextVia is a context-accepting variant of TimePulseVia,
+// for callers that want to bound how long they are willing to wait for
+// the pulse to start or end.
+//
+// Cancelling ctx always makes TimePulseContextVia return promptly, but
+// if waiter does not also implement EdgeNotifier, the goroutine left
+// waiting on waiter.WaitForEdge() is not itself cancelled and will keep
+// running (and leak) until that wait returns on its own, if ever. Only
+// waiters that also implement EdgeNotifier are genuinely abortable.
+func TimePulseContextVia(ctx context.Context, waiter EdgeWaiter, state Value) (duration time.Duration, err error) {
+	return timePulseVia(ctx, waiter, state)
+}
+
+func timePulseVia(ctx context.Context, waiter EdgeWaiter, state Value) (duration time.Duration, err error) {
+	startEdge := RisingEdge
+	endEdge := FallingEdge
+	if state == Low {
+		startEdge, endEdge = endEdge, startEdge
+	}
+
+	if err := waiter.SetSensitivity(startEdge); err != nil {
+		return 0, err
+	}
+	if err := waitForEdgeContext(ctx, waiter); err != nil {
+		return 0, err
+	}
+	start := time.Now()
+
+	if err := waiter.SetSensitivity(endEdge); err != nil {
+		return 0, err
+	}
+	if err := waitForEdgeContext(ctx, waiter); err != nil {
+		return 0, err
+	}
+
+	return time.Since(start), nil
+}
+
+// waitForEdgeContext adapts a plain EdgeWaiter to respect a context,
+// using EdgeNotifier.WaitForEdgeContext directly when available.
+func waitForEdgeContext(ctx context.Context, waiter EdgeWaiter) (err error) {
+	if notifier, ok := waiter.(EdgeNotifier); ok {
+		_, err := notifier.WaitForEdgeContext(ctx)
+		return err
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- waiter.WaitForEdge()
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,82 @@
+package gpio
+
+import (
+	"testing"
+	"time"
+)
+
+// fakeEdgeWaiter is an EdgeWaiter whose WaitForEdge unblocks as soon as
+// a value is sent on its edges channel, simulating a real edge.
+type fakeEdgeWaiter struct {
+	sensitivities []EdgeSensitivity
+	edges         chan struct{}
+}
+
+func (w *fakeEdgeWaiter) SetSensitivity(sensitivity EdgeSensitivity) error {
+	w.sensitivities = append(w.sensitivities, sensitivity)
+	return nil
+}
+
+func (w *fakeEdgeWaiter) WaitForEdge() error {
+	<-w.edges
+	return nil
+}
+
+func TestTimePulseVia(t *testing.T) {
+	w := &fakeEdgeWaiter{edges: make(chan struct{})}
+
+	done := make(chan struct{})
+	var duration time.Duration
+	var err error
+	go func() {
+		duration, err = TimePulseVia(w, High)
+		close(done)
+	}()
+
+	// Give TimePulseVia a chance to set its initial sensitivity and
+	// start waiting for the rising edge before we simulate it.
+	time.Sleep(10 * time.Millisecond)
+	w.edges <- struct{}{}
+
+	time.Sleep(10 * time.Millisecond)
+	w.edges <- struct{}{}
+
+	<-done
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if duration <= 0 {
+		t.Fatalf("expected a positive duration, got %s", duration)
+	}
+	want := []EdgeSensitivity{RisingEdge, FallingEdge}
+	if len(w.sensitivities) != len(want) {
+		t.Fatalf("sensitivities = %v, want %v", w.sensitivities, want)
+	}
+	for i, s := range want {
+		if w.sensitivities[i] != s {
+			t.Fatalf("sensitivities = %v, want %v", w.sensitivities, want)
+		}
+	}
+}
+
+func TestTimePulseViaLowState(t *testing.T) {
+	w := &fakeEdgeWaiter{edges: make(chan struct{})}
+
+	done := make(chan struct{})
+	go func() {
+		TimePulseVia(w, Low)
+		close(done)
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	w.edges <- struct{}{}
+	time.Sleep(10 * time.Millisecond)
+	w.edges <- struct{}{}
+	<-done
+
+	want := []EdgeSensitivity{FallingEdge, RisingEdge}
+	if len(w.sensitivities) != len(want) || w.sensitivities[0] != want[0] || w.sensitivities[1] != want[1] {
+		t.Fatalf("sensitivities = %v, want %v", w.sensitivities, want)
+	}
+}
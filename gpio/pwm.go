@@ -0,0 +1,54 @@
+package gpio
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+// Duty is a PWM duty cycle, expressed as a fraction of DutyMax rather
+// than as a percentage so that it can be represented exactly as an
+// integer.
+type Duty uint16
+
+const (
+	// DutyMax represents a 100% duty cycle: the signal is High for the
+	// entirety of each period.
+	DutyMax Duty = 65535
+
+	// DutyHalf represents an (approximately) 50% duty cycle.
+	DutyHalf Duty = DutyMax / 2
+)
+
+// ParseDuty parses a duty cycle given as a percentage string, such as
+// "50" or "12.5", returning the nearest representable Duty value.
+func ParseDuty(s string) (duty Duty, err error) {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duty cycle %q: %s", s, err)
+	}
+	if math.IsNaN(f) || f < 0 || f > 100 {
+		return 0, fmt.Errorf("duty cycle %q out of range 0-100", s)
+	}
+	return Duty(math.Round(f / 100 * float64(DutyMax))), nil
+}
+
+// A PWMPin can generate a pulse-width-modulated signal, oscillating
+// between Low and High at the given frequency with the given duty
+// cycle.
+//
+// PWMPin is deliberately kept separate from GpioPin so that consumers
+// which need PWM can depend on it directly, making their hardware
+// requirements clear, rather than depending on a GpioPin and hoping it
+// also happens to support PWM.
+type PWMPin interface {
+	// PWM starts (or reconfigures, if already running) generation of a
+	// PWM signal with the given duty cycle and frequency, where freq
+	// is the duration of one full period.
+	PWM(duty Duty, freq time.Duration) (err error)
+
+	// StopPWM stops PWM generation, leaving the pin in an
+	// implementation-defined state.
+	StopPWM() (err error)
+}
@@ -0,0 +1,37 @@
+package gpio
+
+import "testing"
+
+func TestParseDuty(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Duty
+		wantErr bool
+	}{
+		{"0", 0, false},
+		{"100", DutyMax, false},
+		{"50", 32768, false}, // 32767.5 rounds up, not truncates to 32767
+		{"12.5", 8192, false},
+		{"-1", 0, true},
+		{"101", 0, true},
+		{"NaN", 0, true},
+		{"Inf", 0, true},
+		{"not a number", 0, true},
+	}
+	for _, test := range tests {
+		got, err := ParseDuty(test.in)
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("ParseDuty(%q): expected an error, got duty %d", test.in, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseDuty(%q): unexpected error: %s", test.in, err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("ParseDuty(%q) = %d, want %d", test.in, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+// Package softpwm provides a software-driven implementation of
+// gpio.PWMPin, for hardware that has no dedicated PWM peripheral (or
+// whose driver doesn't yet expose one) but can still toggle a
+// gpio.ValueSetter quickly enough to approximate PWM in a goroutine.
+//
+// Software PWM is inherently less precise than hardware PWM: its
+// timing is at the mercy of the Go scheduler and the OS, so it is best
+// suited to slow signals such as LED brightness or simple actuators
+// rather than anything timing-critical.
+package softpwm
+
+import (
+	"sync"
+	"time"
+
+	"github.com/apparentlymart/go-gpio/gpio"
+)
+
+// Pin wraps a gpio.ValueSetter and implements gpio.PWMPin on top of it
+// by toggling the underlying pin High and Low from a background
+// goroutine.
+type Pin struct {
+	setter gpio.ValueSetter
+
+	mu   sync.Mutex
+	stop chan struct{}
+	done chan struct{}
+}
+
+// New returns a Pin that drives PWM on the given ValueSetter in
+// software. The returned Pin does not generate any signal until PWM is
+// called.
+func New(setter gpio.ValueSetter) *Pin {
+	return &Pin{setter: setter}
+}
+
+// PWM implements gpio.PWMPin, starting (or reconfiguring) a background
+// goroutine that toggles the underlying pin to approximate the given
+// duty cycle and frequency. A duty of 0 or gpio.DutyMax instead holds
+// the pin Low or High respectively, with no goroutine running.
+func (pin *Pin) PWM(duty gpio.Duty, freq time.Duration) (err error) {
+	pin.mu.Lock()
+	defer pin.mu.Unlock()
+
+	pin.stopLocked()
+
+	if duty == 0 {
+		return pin.setter.SetValue(gpio.Low)
+	}
+	if duty == gpio.DutyMax {
+		return pin.setter.SetValue(gpio.High)
+	}
+
+	highTime := time.Duration(int64(freq) * int64(duty) / int64(gpio.DutyMax))
+	lowTime := freq - highTime
+
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	pin.stop = stop
+	pin.done = done
+
+	go func() {
+		defer close(done)
+		for {
+			if err := pin.setter.SetValue(gpio.High); err != nil {
+				return
+			}
+			select {
+			case <-time.After(highTime):
+			case <-stop:
+				return
+			}
+			if err := pin.setter.SetValue(gpio.Low); err != nil {
+				return
+			}
+			select {
+			case <-time.After(lowTime):
+			case <-stop:
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+// StopPWM implements gpio.PWMPin, stopping the background toggling
+// goroutine and leaving the underlying pin Low.
+func (pin *Pin) StopPWM() (err error) {
+	pin.mu.Lock()
+	defer pin.mu.Unlock()
+
+	pin.stopLocked()
+	return pin.setter.SetValue(gpio.Low)
+}
+
+// stopLocked stops any running toggling goroutine. pin.mu must be held.
+func (pin *Pin) stopLocked() {
+	if pin.stop == nil {
+		return
+	}
+	close(pin.stop)
+	<-pin.done
+	pin.stop = nil
+	pin.done = nil
+}
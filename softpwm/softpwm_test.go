@@ -0,0 +1,85 @@
+package softpwm
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/apparentlymart/go-gpio/gpio"
+)
+
+type recordingSetter struct {
+	mu     sync.Mutex
+	values []gpio.Value
+}
+
+func (s *recordingSetter) SetValue(value gpio.Value) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.values = append(s.values, value)
+	return nil
+}
+
+func (s *recordingSetter) last() gpio.Value {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.values[len(s.values)-1]
+}
+
+func (s *recordingSetter) count() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.values)
+}
+
+func TestPinZeroDutyDrivesLow(t *testing.T) {
+	setter := &recordingSetter{}
+	pin := New(setter)
+
+	if err := pin.PWM(0, time.Millisecond); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := setter.last(); got != gpio.Low {
+		t.Fatalf("SetValue last called with %s, want Low", got)
+	}
+	if err := pin.StopPWM(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestPinMaxDutyDrivesHigh(t *testing.T) {
+	setter := &recordingSetter{}
+	pin := New(setter)
+
+	if err := pin.PWM(gpio.DutyMax, time.Millisecond); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := setter.last(); got != gpio.High {
+		t.Fatalf("SetValue last called with %s, want High", got)
+	}
+	if err := pin.StopPWM(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestPinTogglesForIntermediateDuty(t *testing.T) {
+	setter := &recordingSetter{}
+	pin := New(setter)
+
+	if err := pin.PWM(gpio.DutyHalf, 5*time.Millisecond); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	time.Sleep(50 * time.Millisecond)
+	if err := pin.StopPWM(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// One full cycle is a High and a Low from the toggling goroutine,
+	// plus the Low that StopPWM itself sets.
+	if count := setter.count(); count < 3 {
+		t.Fatalf("expected at least one full toggle cycle, got %d SetValue calls", count)
+	}
+	if got := setter.last(); got != gpio.Low {
+		t.Fatalf("StopPWM should leave the pin Low, got %s", got)
+	}
+}